@@ -0,0 +1,112 @@
+package rancher
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	rancherClient "github.com/rancher/go-rancher/v2"
+)
+
+func dataSourceRancherVolume() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRancherVolumeRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"driver": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"stack_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"driver_opts": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"access_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_host_path": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"external_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRancherVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).EnvironmentClient(d.Get("environment_id").(string))
+	if err != nil {
+		return err
+	}
+
+	filters := make(map[string]interface{})
+	filters["name"] = d.Get("name").(string)
+	if v, ok := d.GetOk("driver"); ok {
+		filters["driver"] = v.(string)
+	}
+	if v, ok := d.GetOk("state"); ok {
+		filters["state"] = v.(string)
+	}
+	if v, ok := d.GetOk("stack_id"); ok {
+		filters["stackId"] = v.(string)
+	}
+
+	volumes, err := client.Volume.List(&rancherClient.ListOpts{Filters: filters})
+	if err != nil {
+		return err
+	}
+
+	if len(volumes.Data) == 0 {
+		return fmt.Errorf("No rancher_volume found matching name %q", d.Get("name").(string))
+	}
+	if len(volumes.Data) > 1 {
+		return fmt.Errorf("Multiple rancher_volume resources matched name %q; use additional filters to narrow the result", d.Get("name").(string))
+	}
+
+	volume := volumes.Data[0]
+
+	d.SetId(volume.Id)
+	d.Set("description", volume.Description)
+	d.Set("driver", volume.Driver)
+	d.Set("driver_opts", volume.DriverOpts)
+	d.Set("access_mode", volume.AccessMode)
+	d.Set("is_host_path", volume.IsHostPath)
+	d.Set("external_id", volume.ExternalId)
+	d.Set("image_id", volume.ImageId)
+	d.Set("data", volume.Data)
+	d.Set("state", volume.State)
+	d.Set("environment_id", volume.AccountId)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package rancher
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccRancherVolumeDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancherVolumeDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rancher_volume.foo", "driver", "rancher-nfs"),
+					resource.TestCheckResourceAttr("data.rancher_volume.foo", "description", "volume test"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRancherVolumeDataSourceConfig = `
+resource "rancher_environment" "foo_volume" {
+	name = "volume data source test"
+	description = "environment to test the volume data source"
+	orchestration = "cattle"
+}
+
+resource "rancher_volume" "foo" {
+  name = "foo"
+  description = "volume test"
+  driver = "rancher-nfs"
+  environment_id = "${rancher_environment.foo_volume.id}"
+}
+
+data "rancher_volume" "foo" {
+  name = "${rancher_volume.foo.name}"
+  environment_id = "${rancher_environment.foo_volume.id}"
+}
+`
@@ -0,0 +1,52 @@
+package rancher
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Provider returns the Rancher terraform.ResourceProvider, wiring the
+// resources and data sources implemented in this package.
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"api_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("RANCHER_URL", nil),
+			},
+			"access_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("RANCHER_ACCESS_KEY", nil),
+			},
+			"secret_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("RANCHER_SECRET_KEY", nil),
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"rancher_volume":          resourceRancherVolume(),
+			"rancher_volume_snapshot": resourceRancherVolumeSnapshot(),
+			"rancher_volume_prune":    resourceRancherVolumePrune(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"rancher_volume": dataSourceRancherVolume(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := &Config{
+		APIURL:    d.Get("api_url").(string),
+		AccessKey: d.Get("access_key").(string),
+		SecretKey: d.Get("secret_key").(string),
+	}
+
+	return config, nil
+}
@@ -1,6 +1,7 @@
 package rancher
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -20,6 +21,12 @@ func resourceRancherVolume() *schema.Resource {
 			State: resourceRancherVolumeImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -42,6 +49,45 @@ func resourceRancherVolume() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"driver_opts": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"access_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "singleHostRW",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "singleHostRW" && value != "multiHostRW" {
+						errors = append(errors, fmt.Errorf(
+							"%q must be one of 'singleHostRW' or 'multiHostRW', got: %s", k, value))
+					}
+					return
+				},
+			},
+			"is_host_path": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"external_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"data": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -61,17 +107,26 @@ func resourceRancherVolumeCreate(d *schema.ResourceData, meta interface{}) error
 		Name:        name,
 		Description: description,
 		Driver:      driver,
+		DriverOpts:  d.Get("driver_opts").(map[string]interface{}),
+		AccessMode:  d.Get("access_mode").(string),
+		IsHostPath:  d.Get("is_host_path").(bool),
+		ExternalId:  d.Get("external_id").(string),
+		ImageId:     d.Get("image_id").(string),
+		Data:        d.Get("data").(map[string]interface{}),
 	}
 	newVolume, err := client.Volume.Create(&volume)
 	if err != nil {
 		return err
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"inactive"},
 		Target:     []string{"inactive"},
-		Refresh:    VolumeStateRefreshFunc(client, newVolume.Id),
-		Timeout:    10 * time.Minute,
+		Refresh:    VolumeStateRefreshFunc(ctx, client, newVolume.Id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      1 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -117,6 +172,12 @@ func resourceRancherVolumeRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", volume.Name)
 	d.Set("driver", volume.Driver)
 	d.Set("environment_id", volume.AccountId)
+	d.Set("driver_opts", volume.DriverOpts)
+	d.Set("access_mode", volume.AccessMode)
+	d.Set("is_host_path", volume.IsHostPath)
+	d.Set("external_id", volume.ExternalId)
+	d.Set("image_id", volume.ImageId)
+	d.Set("data", volume.Data)
 
 	return nil
 }
@@ -137,6 +198,7 @@ func resourceRancherVolumeUpdate(d *schema.ResourceData, meta interface{}) error
 
 	volume.Name = name
 	volume.Description = description
+	volume.Data = d.Get("data").(map[string]interface{})
 	client.Volume.Update(volume, &volume)
 
 	return resourceRancherVolumeRead(d, meta)
@@ -155,13 +217,16 @@ func resourceRancherVolumeDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
 	log.Printf("[DEBUG] Waiting for volume (%s) to be detached or inactive", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"active", "deactivating"},
 		Target:     []string{"inactive", "detached"},
-		Refresh:    VolumeStateRefreshFunc(client, id),
-		Timeout:    10 * time.Minute,
+		Refresh:    VolumeStateRefreshFunc(ctx, client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      1 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -187,8 +252,8 @@ func resourceRancherVolumeDelete(d *schema.ResourceData, meta interface{}) error
 	stateConf = &resource.StateChangeConf{
 		Pending:    []string{"inactive", "detached", "removed", "removing"},
 		Target:     []string{"removed"},
-		Refresh:    VolumeStateRefreshFunc(client, id),
-		Timeout:    10 * time.Minute,
+		Refresh:    VolumeStateRefreshFunc(ctx, client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      1 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -223,9 +288,15 @@ func resourceRancherVolumeImport(d *schema.ResourceData, meta interface{}) ([]*s
 }
 
 // VolumeStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
-// a Rancher Volume.
-func VolumeStateRefreshFunc(client *rancherClient.RancherClient, volumeID string) resource.StateRefreshFunc {
+// a Rancher Volume. It returns ctx's error instead of polling once ctx is done.
+// The callers in this package bound ctx by the resource's timeout only; ctx is
+// not cancelled when Terraform is interrupted.
+func VolumeStateRefreshFunc(ctx context.Context, client *rancherClient.RancherClient, volumeID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, "", err
+		}
+
 		env, err := client.Volume.ById(volumeID)
 
 		if err != nil {
@@ -0,0 +1,184 @@
+package rancher
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	rancherClient "github.com/rancher/go-rancher/v2"
+)
+
+func resourceRancherVolumePrune() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancherVolumePruneCreate,
+		Read:   resourceRancherVolumePruneRead,
+		Delete: resourceRancherVolumePruneDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filter": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"dry_run": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"pruned_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceRancherVolumePruneCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Pruning dangling volumes")
+	client, err := meta.(*Config).EnvironmentClient(d.Get("environment_id").(string))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	dryRun := d.Get("dry_run").(bool)
+	filter := d.Get("filter").(map[string]interface{})
+
+	volumes, err := client.Volume.List(&rancherClient.ListOpts{})
+	if err != nil {
+		return err
+	}
+
+	var prunedIDs []string
+	for _, volume := range volumes.Data {
+		orphaned, err := isOrphanedVolume(volume, filter)
+		if err != nil {
+			return err
+		}
+		if !orphaned {
+			continue
+		}
+
+		prunedIDs = append(prunedIDs, volume.Id)
+
+		if dryRun {
+			continue
+		}
+
+		if _, err := client.Volume.ActionRemove(&volume); err != nil {
+			return fmt.Errorf("Error removing orphaned volume (%s): %s", volume.Id, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"inactive", "detached", "removing"},
+			Target:     []string{"removed"},
+			Refresh:    VolumeStateRefreshFunc(ctx, client, volume.Id),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      1 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		if _, waitErr := stateConf.WaitForState(); waitErr != nil {
+			return fmt.Errorf(
+				"Error waiting for orphaned volume (%s) to be removed: %s", volume.Id, waitErr)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s-prune", d.Get("environment_id").(string)))
+	d.Set("pruned_ids", prunedIDs)
+	log.Printf("[INFO] Pruned %d orphaned volume(s)", len(prunedIDs))
+
+	return nil
+}
+
+func resourceRancherVolumePruneRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceRancherVolumePruneDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// isOrphanedVolume reports whether a volume is detached/inactive, unattached to
+// any instance, not managed by a stack, and matches the optional driver/age/
+// label-regex filter supplied by the rancher_volume_prune resource. Unknown
+// filter keys are rejected rather than silently ignored, since a dropped
+// safety filter would widen the set of volumes this resource removes.
+func isOrphanedVolume(volume rancherClient.Volume, filter map[string]interface{}) (bool, error) {
+	for key := range filter {
+		switch key {
+		case "driver", "age", "label-regex":
+		default:
+			return false, fmt.Errorf(
+				"unsupported rancher_volume_prune filter key %q (expected one of: driver, age, label-regex)", key)
+		}
+	}
+
+	if volume.State != "detached" && volume.State != "inactive" {
+		return false, nil
+	}
+	if volume.InstanceId != "" {
+		return false, nil
+	}
+	if volume.StackId != "" {
+		return false, nil
+	}
+
+	if driver, ok := filter["driver"]; ok && driver.(string) != volume.Driver {
+		return false, nil
+	}
+
+	if age, ok := filter["age"]; ok {
+		maxAge, err := time.ParseDuration(age.(string))
+		if err != nil {
+			return false, fmt.Errorf("invalid age filter %q: %s", age.(string), err)
+		}
+		created, err := time.Parse(time.RFC3339, volume.Created)
+		if err != nil {
+			return false, fmt.Errorf("unable to parse created timestamp for volume (%s): %s", volume.Id, err)
+		}
+		if time.Since(created) < maxAge {
+			return false, nil
+		}
+	}
+
+	if pattern, ok := filter["label-regex"]; ok {
+		re, err := regexp.Compile(pattern.(string))
+		if err != nil {
+			return false, fmt.Errorf("invalid label-regex filter %q: %s", pattern.(string), err)
+		}
+		matched := false
+		for k, v := range volume.Labels {
+			if re.MatchString(k) || re.MatchString(fmt.Sprintf("%v", v)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
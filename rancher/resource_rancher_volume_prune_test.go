@@ -0,0 +1,38 @@
+package rancher
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccRancherVolumePrune_dryRun(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancherVolumePruneDryRunConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rancher_volume_prune.foo", "dry_run", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRancherVolumePruneDryRunConfig = `
+resource "rancher_environment" "foo_prune" {
+	name = "volume prune test"
+	description = "environment to test volume pruning"
+	orchestration = "cattle"
+}
+
+resource "rancher_volume_prune" "foo" {
+  environment_id = "${rancher_environment.foo_prune.id}"
+  dry_run = true
+  filter = {
+    driver = "rancher-nfs"
+  }
+}
+`
@@ -0,0 +1,216 @@
+package rancher
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	rancherClient "github.com/rancher/go-rancher/v2"
+)
+
+func resourceRancherVolumeSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancherVolumeSnapshotCreate,
+		Read:   resourceRancherVolumeSnapshotRead,
+		Delete: resourceRancherVolumeSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancherVolumeSnapshotImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRancherVolumeSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Creating VolumeSnapshot: %s", d.Id())
+	client, err := meta.(*Config).EnvironmentClient(d.Get("environment_id").(string))
+	if err != nil {
+		return err
+	}
+
+	volumeID := d.Get("volume_id").(string)
+	volume, err := client.Volume.ById(volumeID)
+	if err != nil {
+		return err
+	}
+
+	snapshot := rancherClient.Snapshot{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		VolumeId:    volumeID,
+	}
+
+	newSnapshot, err := client.Volume.ActionSnapshot(volume, &snapshot)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active", "activating"},
+		Target:     []string{"active"},
+		Refresh:    SnapshotStateRefreshFunc(ctx, client, newSnapshot.Id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"Error waiting for volume snapshot (%s) to be created: %s", newSnapshot.Id, waitErr)
+	}
+
+	d.SetId(newSnapshot.Id)
+	log.Printf("[INFO] VolumeSnapshot ID: %s", d.Id())
+
+	return resourceRancherVolumeSnapshotRead(d, meta)
+}
+
+func resourceRancherVolumeSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing VolumeSnapshot: %s", d.Id())
+	client, err := meta.(*Config).EnvironmentClient(d.Get("environment_id").(string))
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := client.Snapshot.ById(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if snapshot == nil {
+		log.Printf("[INFO] VolumeSnapshot %s not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if removed(snapshot.State) {
+		log.Printf("[INFO] VolumeSnapshot %s was removed on %v", d.Id(), snapshot.Removed)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] VolumeSnapshot Name: %s", snapshot.Name)
+
+	d.Set("name", snapshot.Name)
+	d.Set("description", snapshot.Description)
+	d.Set("volume_id", snapshot.VolumeId)
+	d.Set("environment_id", snapshot.AccountId)
+
+	return nil
+}
+
+func resourceRancherVolumeSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting VolumeSnapshot: %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).EnvironmentClient(d.Get("environment_id").(string))
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := client.Snapshot.ById(id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Snapshot.ActionRemove(snapshot); err != nil {
+		return fmt.Errorf("Error removing volume snapshot: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for volume snapshot (%s) to be removed", id)
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active", "removed", "removing"},
+		Target:     []string{"removed"},
+		Refresh:    SnapshotStateRefreshFunc(ctx, client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"Error waiting for volume snapshot (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRancherVolumeSnapshotImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	envID, resourceID := splitID(d.Id())
+	d.SetId(resourceID)
+	if envID != "" {
+		d.Set("environment_id", envID)
+	} else {
+		client, err := meta.(*Config).GlobalClient()
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+		snapshot, err := client.Snapshot.ById(d.Id())
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+		d.Set("environment_id", snapshot.AccountId)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// SnapshotStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// a Rancher Volume Snapshot. It returns ctx's error instead of polling once ctx
+// is done. The callers in this package bound ctx by the resource's timeout only;
+// ctx is not cancelled when Terraform is interrupted.
+func SnapshotStateRefreshFunc(ctx context.Context, client *rancherClient.RancherClient, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, "", err
+		}
+
+		snapshot, err := client.Snapshot.ById(snapshotID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return snapshot, snapshot.State, nil
+	}
+}
@@ -0,0 +1,109 @@
+package rancher
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	rancherClient "github.com/rancher/go-rancher/v2"
+)
+
+func TestAccRancherVolumeSnapshot_basic(t *testing.T) {
+	var snapshot rancherClient.Snapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancherVolumeSnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancherVolumeSnapshotConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancherVolumeSnapshotExists("rancher_volume_snapshot.foo", &snapshot),
+					resource.TestCheckResourceAttr("rancher_volume_snapshot.foo", "name", "foo-snapshot"),
+					resource.TestCheckResourceAttr("rancher_volume_snapshot.foo", "description", "snapshot test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRancherVolumeSnapshotExists(n string, snapshot *rancherClient.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No App Name is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).EnvironmentClient(rs.Primary.Attributes["environment_id"])
+		if err != nil {
+			return err
+		}
+
+		foundSnapshot, err := client.Snapshot.ById(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if foundSnapshot.Resource.Id != rs.Primary.ID {
+			return fmt.Errorf("Volume snapshot not found")
+		}
+
+		*snapshot = *foundSnapshot
+
+		return nil
+	}
+}
+
+func testAccCheckRancherVolumeSnapshotDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "rancher_volume_snapshot" {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).GlobalClient()
+		if err != nil {
+			return err
+		}
+
+		snapshot, err := client.Snapshot.ById(rs.Primary.ID)
+
+		if err == nil {
+			if snapshot != nil &&
+				snapshot.Resource.Id == rs.Primary.ID &&
+				snapshot.State != "removed" {
+				return fmt.Errorf("Volume snapshot still exists")
+			}
+		}
+
+		return nil
+	}
+	return nil
+}
+
+const testAccRancherVolumeSnapshotConfig = `
+resource "rancher_environment" "foo_snapshot" {
+	name = "volume snapshot test"
+	description = "environment to test volume snapshots"
+	orchestration = "cattle"
+}
+
+resource "rancher_volume" "foo" {
+  name = "foo"
+  description = "volume test"
+  driver = "rancher-nfs"
+  environment_id = "${rancher_environment.foo_snapshot.id}"
+}
+
+resource "rancher_volume_snapshot" "foo" {
+  name = "foo-snapshot"
+  description = "snapshot test"
+  volume_id = "${rancher_volume.foo.id}"
+  environment_id = "${rancher_environment.foo_snapshot.id}"
+}
+`
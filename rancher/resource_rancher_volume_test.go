@@ -1,6 +1,7 @@
 package rancher
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
@@ -77,12 +78,15 @@ func testAccRancherVolumeDisappears(vol *rancherClient.Volume) resource.TestChec
 			return err
 		}
 
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancel()
+
 		log.Printf("[DEBUG] Waiting for volume (%s) to be detached or inactive", vol.Id)
 
 		stateConf := &resource.StateChangeConf{
 			Pending:    []string{"active", "deactivating"},
 			Target:     []string{"inactive", "detached"},
-			Refresh:    VolumeStateRefreshFunc(client, vol.Id),
+			Refresh:    VolumeStateRefreshFunc(ctx, client, vol.Id),
 			Timeout:    10 * time.Minute,
 			Delay:      1 * time.Second,
 			MinTimeout: 3 * time.Second,
@@ -108,7 +112,7 @@ func testAccRancherVolumeDisappears(vol *rancherClient.Volume) resource.TestChec
 		stateConf = &resource.StateChangeConf{
 			Pending:    []string{"inactive", "detached", "removing"},
 			Target:     []string{"removed"},
-			Refresh:    VolumeStateRefreshFunc(client, vol.Id),
+			Refresh:    VolumeStateRefreshFunc(ctx, client, vol.Id),
 			Timeout:    10 * time.Minute,
 			Delay:      1 * time.Second,
 			MinTimeout: 3 * time.Second,
@@ -230,3 +234,45 @@ const testAccRancherVolumeRecreateConfig = `
    environment_id = "${rancher_environment.foo_volume2.id}"
  }
  `
+
+func TestAccRancherVolume_driverOpts(t *testing.T) {
+	var volume rancherClient.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancherVolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancherVolumeDriverOptsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancherVolumeExists("rancher_volume.foo", &volume),
+					resource.TestCheckResourceAttr("rancher_volume.foo", "driver", "rancher-ebs"),
+					resource.TestCheckResourceAttr("rancher_volume.foo", "access_mode", "multiHostRW"),
+					resource.TestCheckResourceAttr("rancher_volume.foo", "driver_opts.size", "20"),
+					resource.TestCheckResourceAttr("rancher_volume.foo", "driver_opts.volumetype", "gp2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRancherVolumeDriverOptsConfig = `
+resource "rancher_environment" "foo_volume" {
+	name = "volume test"
+	description = "environment to test volumes"
+	orchestration = "cattle"
+}
+
+resource "rancher_volume" "foo" {
+  name = "foo"
+  description = "volume test"
+  driver = "rancher-ebs"
+  access_mode = "multiHostRW"
+  driver_opts = {
+    size = "20"
+    volumetype = "gp2"
+  }
+  environment_id = "${rancher_environment.foo_volume.id}"
+}
+`